@@ -79,3 +79,40 @@ func TestCheckCompatible(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckConfigForkOrder(t *testing.T) {
+	withForks := func(churrito, donut *big.Int) *ChainConfig {
+		return &ChainConfig{
+			ChainID:             big.NewInt(1337),
+			HomesteadBlock:      big.NewInt(0),
+			EIP150Block:         big.NewInt(0),
+			EIP155Block:         big.NewInt(0),
+			EIP158Block:         big.NewInt(0),
+			ByzantiumBlock:      big.NewInt(0),
+			ConstantinopleBlock: big.NewInt(0),
+			PetersburgBlock:     big.NewInt(0),
+			IstanbulBlock:       big.NewInt(0),
+			ChurritoBlock:       churrito,
+			DonutBlock:          donut,
+		}
+	}
+	tests := []struct {
+		config  *ChainConfig
+		wantErr bool
+	}{
+		{config: IstanbulTestChainConfig, wantErr: false},
+		{config: withForks(nil, nil), wantErr: false},
+		{config: withForks(big.NewInt(10), nil), wantErr: false},
+		{config: withForks(big.NewInt(10), big.NewInt(20)), wantErr: false},
+		{config: withForks(big.NewInt(10), big.NewInt(10)), wantErr: false},
+		{config: withForks(big.NewInt(20), big.NewInt(10)), wantErr: true},
+		{config: withForks(nil, big.NewInt(10)), wantErr: true},
+	}
+
+	for _, test := range tests {
+		err := test.config.CheckConfigForkOrder()
+		if (err != nil) != test.wantErr {
+			t.Errorf("fork order mismatch:\nconfig: %v\nerr: %v\nwantErr: %v", test.config, err, test.wantErr)
+		}
+	}
+}